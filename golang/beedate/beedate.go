@@ -0,0 +1,186 @@
+// Package beedate provides a Date type that natively represents Thai
+// Buddhist Era (พ.ศ.) dates, with the same JSON-serializable, string-based
+// ergonomics as a compact calendar-date type: parsing, formatting, and
+// round-tripping through JSON without ever going through a Gregorian year
+// that silently means the wrong thing.
+package beedate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// BEOffset is the number of years the Buddhist Era is ahead of the
+// Christian (Gregorian) Era, e.g. CE 2021 is BE 2564.
+const BEOffset = 543
+
+// ThaiMonths are the full Thai names for January through December, indexed
+// from 0 (January).
+var ThaiMonths = [...]string{
+	"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน",
+	"กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม",
+}
+
+// Era identifies which calendar a date string's year is expressed in.
+type Era int
+
+const (
+	// BuddhistEra years are CE+543, e.g. 2564.
+	BuddhistEra Era = iota
+	// ChristianEra years are the familiar Gregorian years, e.g. 2021.
+	ChristianEra
+)
+
+// Date is a calendar date expressed in the Buddhist Era. The month and day
+// are shared with the Gregorian calendar; only the year is offset.
+type Date struct {
+	year  int // Buddhist Era year
+	month int // 1-12
+	day   int
+}
+
+// New builds a Date from its Buddhist Era year, month and day, validating
+// the result (including Gregorian leap-year rules for February 29).
+func New(beYear, month, day int) (Date, error) {
+	return newDate(beYear, month, day)
+}
+
+// Parse parses a date string into a Date. A "BE" or "CE" prefix makes the
+// era explicit (e.g. "CE2021-06-01"); without one the year is assumed to
+// already be expressed in the Buddhist Era, e.g. "2564-06-01".
+func Parse(s string) (Date, error) {
+	era := BuddhistEra
+	switch {
+	case strings.HasPrefix(s, "BE"):
+		s = s[len("BE"):]
+	case strings.HasPrefix(s, "CE"):
+		era = ChristianEra
+		s = s[len("CE"):]
+	}
+	return ParseInEra(s, era)
+}
+
+// dateShape matches the fixed-width "YYYY-MM-DD" shape ParseInEra accepts,
+// rejecting both trailing garbage and field widths Sscanf would otherwise
+// read past (e.g. a 3-digit day).
+var dateShape = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// ParseInEra parses an ISO-8601-shaped "YYYY-MM-DD" string whose year is
+// explicitly known to be in the given Era.
+//
+// This deliberately does not delegate to time.Parse: time.Parse validates
+// Feb 29 against the literal year in the string, which is wrong for a BE
+// year (e.g. "2563-02-29" is valid because CE 2020 is a leap year, even
+// though 2563 itself is not divisible by 4).
+func ParseInEra(s string, era Era) (Date, error) {
+	if !dateShape.MatchString(s) {
+		return Date{}, fmt.Errorf("beedate: parse %q: not in YYYY-MM-DD form", s)
+	}
+	var year, month, day int
+	if _, err := fmt.Sscanf(s, "%d-%d-%d", &year, &month, &day); err != nil {
+		return Date{}, fmt.Errorf("beedate: parse %q: %w", s, err)
+	}
+	if era == ChristianEra {
+		year += BEOffset
+	}
+	return newDate(year, month, day)
+}
+
+func newDate(beYear, month, day int) (Date, error) {
+	if month < 1 || month > 12 {
+		return Date{}, fmt.Errorf("beedate: invalid month %d", month)
+	}
+	max := DaysInMonth(beYear-BEOffset, time.Month(month))
+	if day < 1 || day > max {
+		return Date{}, fmt.Errorf("beedate: invalid day %d for %04d-%02d (พ.ศ.)", day, beYear, month)
+	}
+	return Date{year: beYear, month: month, day: day}, nil
+}
+
+// IsLeapYear reports whether the given Gregorian year is a leap year
+// (divisible by 4, and not by 100 unless also by 400).
+func IsLeapYear(gregorianYear int) bool {
+	return gregorianYear%4 == 0 && (gregorianYear%100 != 0 || gregorianYear%400 == 0)
+}
+
+// DaysInMonth returns the number of days in the given month of the given
+// Gregorian year.
+func DaysInMonth(gregorianYear int, month time.Month) int {
+	switch month {
+	case time.January, time.March, time.May, time.July, time.August, time.October, time.December:
+		return 31
+	case time.April, time.June, time.September, time.November:
+		return 30
+	case time.February:
+		if IsLeapYear(gregorianYear) {
+			return 29
+		}
+		return 28
+	default:
+		return 0
+	}
+}
+
+// Year returns the Buddhist Era year.
+func (d Date) Year() int { return d.year }
+
+// Month returns the (Gregorian-numbered) month.
+func (d Date) Month() time.Month { return time.Month(d.month) }
+
+// Day returns the day of month.
+func (d Date) Day() int { return d.day }
+
+// ToGregorian returns the Gregorian equivalent of d, at midnight UTC.
+func (d Date) ToGregorian() time.Time {
+	return time.Date(d.year-BEOffset, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC)
+}
+
+// FromGregorian converts a Gregorian time.Time into its Buddhist Era Date.
+func FromGregorian(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year: year + BEOffset, month: int(month), day: day}
+}
+
+// String returns d in "YYYY-MM-DD" form with a Buddhist Era year, e.g.
+// "2564-06-01".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.year, d.month, d.day)
+}
+
+// Format renders d using a time.Format-style layout. The literal token
+// "พ.ศ.2006" is recognized specially and substituted with the Buddhist Era
+// year instead of the Gregorian one; every other token is delegated to the
+// Gregorian equivalent of d.
+func (d Date) Format(layout string) string {
+	const beToken = "พ.ศ.2006"
+	if !strings.Contains(layout, beToken) {
+		return d.ToGregorian().Format(layout)
+	}
+	const placeholder = "\x00"
+	formatted := d.ToGregorian().Format(strings.ReplaceAll(layout, beToken, placeholder))
+	return strings.ReplaceAll(formatted, placeholder, fmt.Sprintf("พ.ศ.%d", d.year))
+}
+
+// MarshalJSON implements json.Marshaler, encoding d as its "YYYY-MM-DD" BE
+// string form.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the same "BE"/"CE"
+// prefixed forms as Parse.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,80 @@
+package beedate
+
+import "testing"
+
+func TestParseDefaultsToBuddhistEra(t *testing.T) {
+	d, err := Parse("2564-06-01")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if d.Year() != 2564 {
+		t.Errorf("Year() = %d, want 2564", d.Year())
+	}
+	g := d.ToGregorian()
+	if g.Year() != 2021 {
+		t.Errorf("ToGregorian().Year() = %d, want 2021", g.Year())
+	}
+}
+
+func TestParseCEPrefix(t *testing.T) {
+	d, err := Parse("CE2021-06-01")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if d.Year() != 2564 {
+		t.Errorf("Year() = %d, want 2564", d.Year())
+	}
+}
+
+func TestParseInvalidLeapDay(t *testing.T) {
+	// BE 2563 -> CE 2020, a leap year: Feb 29 is valid.
+	if _, err := Parse("2563-02-29"); err != nil {
+		t.Errorf("Parse(2563-02-29): unexpected error: %v", err)
+	}
+	// BE 2564 -> CE 2021, not a leap year: Feb 29 is invalid.
+	if _, err := Parse("2564-02-29"); err == nil {
+		t.Error("Parse(2564-02-29): expected error, got none")
+	}
+}
+
+func TestParseRejectsTrailingGarbage(t *testing.T) {
+	if _, err := Parse("2564-06-01xyz"); err == nil {
+		t.Error("Parse(2564-06-01xyz): expected error, got none")
+	}
+}
+
+func TestParseRejectsOverWidthField(t *testing.T) {
+	if _, err := Parse("2564-06-015"); err == nil {
+		t.Error("Parse(2564-06-015): expected error, got none")
+	}
+}
+
+func TestFormatThaiEraToken(t *testing.T) {
+	d, err := Parse("2564-06-01")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	got := d.Format("02 01 พ.ศ.2006")
+	want := "01 06 พ.ศ.2564"
+	if got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	d, err := Parse("2564-06-01")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	data, err := d.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	var got Date
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if got != d {
+		t.Errorf("round-trip = %v, want %v", got, d)
+	}
+}
@@ -0,0 +1,35 @@
+// Command eligibility-server serves the eligibility Policy over HTTP/JSON.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/eligibility"
+	"github.com/Tatchakorn/Dev-Assignment/golang/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	policyPath := flag.String("policy", "", "path to a Policy JSON or YAML file (defaults to the built-in policy)")
+	flag.Parse()
+
+	policy := eligibility.DefaultPolicy()
+	if *policyPath != "" {
+		f, err := os.Open(*policyPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		policy, err = eligibility.LoadPolicy(f, *policyPath)
+		f.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	srv := server.New(policy)
+	log.Printf("eligibility-server listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, srv.Handler()))
+}
@@ -0,0 +1,189 @@
+// Package eligibility holds the domain types and rules shared by the CLI in
+// main and the HTTP service in server: a Person's age, and the Policy that
+// decides whether a Person is eligible for a service within a Window.
+package eligibility
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+)
+
+type Gender int64
+
+const (
+	MALE Gender = iota
+	FEMALE
+)
+
+func (gender Gender) String() string {
+	switch gender {
+	case MALE:
+		return "male"
+	case FEMALE:
+		return "female"
+	}
+	return "unknown"
+}
+
+// ParseGender parses the case-insensitive "male"/"female" strings used by
+// the JSON API.
+func ParseGender(s string) (Gender, error) {
+	switch strings.ToLower(s) {
+	case "male":
+		return MALE, nil
+	case "female":
+		return FEMALE, nil
+	}
+	return 0, fmt.Errorf("eligibility: unrecognized gender %q", s)
+}
+
+// Person is someone being checked for eligibility.
+type Person struct {
+	Gender    Gender
+	Birthdate beedate.Date
+}
+
+func (p Person) String() string {
+	return fmt.Sprintf("{%s: %d %s พ.ศ.%d}", p.Gender, p.Birthdate.Day(), beedate.ThaiMonths[p.Birthdate.Month()-1], p.Birthdate.Year())
+}
+
+// Age is a person's age in whole years, months and days.
+type Age struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+func (a Age) String() string {
+	return fmt.Sprintf("[%dy %dm %dd]", a.Year, a.Month, a.Day)
+}
+
+// TotalMonths returns the age expressed as a whole number of months,
+// discarding the leftover days. This is the right unit for eligibility
+// thresholds expressed in months (e.g. "at least 6 months old"); the
+// calendar-month remainder in a.Month alone is not, since e.g. 1 year and
+// 2 months is 14 months, not 2.
+func (a Age) TotalMonths() int {
+	return a.Year*12 + a.Month
+}
+
+// effectiveBirthdayDay returns the day of month p's birthday falls on in
+// the given Gregorian year, falling back to Feb 28 for a Feb 29 birthdate
+// in a year that isn't itself a leap year.
+func (p Person) effectiveBirthdayDay(year int) int {
+	birthdate := p.Birthdate.ToGregorian()
+	if birthdate.Month() == time.February && birthdate.Day() == 29 && !beedate.IsLeapYear(year) {
+		return 28
+	}
+	return birthdate.Day()
+}
+
+// CalcAge returns p's age in (years, months, days) relative to setDate.
+func (p Person) CalcAge(setDate time.Time) Age {
+	birthdate := p.Birthdate.ToGregorian()
+	birthdateDay := p.effectiveBirthdayDay(setDate.Year())
+
+	years := setDate.Year() - birthdate.Year()
+	months := int(setDate.Month()) - int(birthdate.Month())
+	days := setDate.Day() - birthdateDay
+
+	if setDate.Day() < birthdateDay {
+		months--
+		prevMonth := setDate.Month() - 1
+		prevMonthYear := setDate.Year()
+		if prevMonth < time.January {
+			prevMonth = time.December
+			prevMonthYear--
+		}
+		daysInPrevMonth := beedate.DaysInMonth(prevMonthYear, prevMonth)
+		// The birthday may not exist in the borrowed month (e.g. day 31
+		// borrowing from a 28/30-day month); clamp it to the days that
+		// month actually has so the completed month ends on its last day.
+		prevBirthdateDay := birthdateDay
+		if prevBirthdateDay > daysInPrevMonth {
+			prevBirthdateDay = daysInPrevMonth
+		}
+		days = setDate.Day() - prevBirthdateDay + daysInPrevMonth
+	}
+	if months < 0 {
+		years--
+		months += 12
+	}
+	return Age{years, months, days}
+}
+
+// Window is the service period a Person's eligibility is evaluated
+// against.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Policy holds the age thresholds that decide eligibility, so that
+// different campaigns (e.g. different vaccination rounds) can run with
+// different rules without recompiling.
+type Policy struct {
+	SeniorLowerAgeYear int `json:"senior_lower_age_year"`
+	ChildLowerAgeMonth int `json:"child_lower_age_month"`
+	ChildUpperAgeYear  int `json:"child_upper_age_year"`
+}
+
+// DefaultPolicy returns the thresholds the CLI has always used: senior
+// citizens 65 years old or older, and children between 6 months and 2
+// years old.
+func DefaultPolicy() Policy {
+	return Policy{
+		SeniorLowerAgeYear: 65,
+		ChildLowerAgeMonth: 6,
+		ChildUpperAgeYear:  2,
+	}
+}
+
+// Evaluate reports whether p is eligible for the service within w, and if
+// so the sub-window of w during which they are eligible (which may start
+// after w.Start or end before w.End, e.g. because they turn 65 partway
+// through). reason identifies which rule matched, for the caller to
+// surface to a human: "senior", "child", "will_be_65", "will_be_6_months",
+// "will_be_2_years", or "ineligible".
+func (pol Policy) Evaluate(p Person, w Window) (eligible bool, start, end time.Time, reason string) {
+	ageStart := p.CalcAge(w.Start)
+	ageEnd := p.CalcAge(w.End)
+	childUpperAgeMonth := pol.ChildUpperAgeYear * 12
+
+	inSeniorRange := ageStart.Year >= pol.SeniorLowerAgeYear
+	inChildrenRange := ageStart.TotalMonths() >= pol.ChildLowerAgeMonth && ageEnd.TotalMonths() <= childUpperAgeMonth
+	willBe65yo := ageEnd.Year == pol.SeniorLowerAgeYear
+	willBe6m := ageStart.TotalMonths() < pol.ChildLowerAgeMonth && ageEnd.TotalMonths() >= pol.ChildLowerAgeMonth && ageEnd.TotalMonths() <= childUpperAgeMonth
+
+	birthdate := p.Birthdate.ToGregorian()
+	childUpperEnd := birthdate.AddDate(pol.ChildUpperAgeYear, 0, 0)
+	// Only true if p is still under the upper bound at w.Start and turns it
+	// during the window; otherwise childUpperEnd falls before w.Start and
+	// there is no eligible sub-window left to report.
+	willBe2yo := ageStart.Year < pol.ChildUpperAgeYear && ageEnd.Year == pol.ChildUpperAgeYear && childUpperEnd.After(w.Start)
+
+	switch {
+	case inSeniorRange:
+		return true, w.Start, w.End, "senior"
+	case inChildrenRange:
+		// TotalMonths floors the day remainder, so a child turning 2 partway
+		// through w still satisfies inChildrenRange; cap end at their 2nd
+		// birthday so eligibility never extends past the upper age bound.
+		end := w.End
+		if childUpperEnd.Before(end) {
+			end = childUpperEnd
+		}
+		return true, w.Start, end, "child"
+	case willBe65yo:
+		return true, birthdate.AddDate(pol.SeniorLowerAgeYear, 0, 0), w.End, "will_be_65"
+	case willBe6m:
+		return true, birthdate.AddDate(0, pol.ChildLowerAgeMonth, 0), w.End, "will_be_6_months"
+	case willBe2yo:
+		return true, w.Start, childUpperEnd, "will_be_2_years"
+	default:
+		return false, time.Time{}, time.Time{}, "ineligible"
+	}
+}
@@ -0,0 +1,147 @@
+package eligibility
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+)
+
+func newPerson(t *testing.T, gregorianDate string) Person {
+	t.Helper()
+	d, err := beedate.ParseInEra(gregorianDate, beedate.ChristianEra)
+	if err != nil {
+		t.Fatalf("ParseInEra(%q): %v", gregorianDate, err)
+	}
+	return Person{Gender: FEMALE, Birthdate: d}
+}
+
+func gregorian(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := beedate.ParseInEra(s, beedate.ChristianEra)
+	if err != nil {
+		t.Fatalf("ParseInEra(%q): %v", s, err)
+	}
+	return d.ToGregorian()
+}
+
+func TestCalcAgeBorrowsMonthWhenDayHasNotArrived(t *testing.T) {
+	p := newPerson(t, "2020-01-15")
+	got := p.CalcAge(gregorian(t, "2021-01-10"))
+	want := Age{Year: 0, Month: 11, Day: 26}
+	if got != want {
+		t.Errorf("CalcAge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalcAgeExactBirthday(t *testing.T) {
+	p := newPerson(t, "2020-01-15")
+	got := p.CalcAge(gregorian(t, "2021-01-15"))
+	want := Age{Year: 1, Month: 0, Day: 0}
+	if got != want {
+		t.Errorf("CalcAge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalcAgeFeb29BirthdayInNonLeapSetYear(t *testing.T) {
+	p := newPerson(t, "2020-02-29")
+	// 2021 is not a leap year, so the birthday falls back to Feb 28.
+	got := p.CalcAge(gregorian(t, "2021-02-28"))
+	want := Age{Year: 1, Month: 0, Day: 0}
+	if got != want {
+		t.Errorf("CalcAge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalcAgeFeb29BirthdayInLeapSetYear(t *testing.T) {
+	p := newPerson(t, "2020-02-29")
+	got := p.CalcAge(gregorian(t, "2024-02-29"))
+	want := Age{Year: 4, Month: 0, Day: 0}
+	if got != want {
+		t.Errorf("CalcAge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalcAgeBorrowsFromShorterMonth(t *testing.T) {
+	p := newPerson(t, "2021-01-31")
+	got := p.CalcAge(gregorian(t, "2021-03-01"))
+	want := Age{Year: 0, Month: 1, Day: 1}
+	if got != want {
+		t.Errorf("CalcAge() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAgeTotalMonths(t *testing.T) {
+	a := Age{Year: 1, Month: 2, Day: 10}
+	if got, want := a.TotalMonths(), 14; got != want {
+		t.Errorf("TotalMonths() = %d, want %d", got, want)
+	}
+}
+
+func TestEvaluateChildBoundaryJustUnder6Months(t *testing.T) {
+	p := newPerson(t, "2021-01-05")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-06-30")}
+	eligible, _, _, reason := DefaultPolicy().Evaluate(p, w)
+	if eligible {
+		t.Errorf("Evaluate() eligible = true, reason = %q, want false for a child just under 6 months old throughout the window", reason)
+	}
+}
+
+func TestEvaluateChildBecomesEligibleDuringWindow(t *testing.T) {
+	p := newPerson(t, "2021-01-05")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-08-31")}
+	eligible, start, _, reason := DefaultPolicy().Evaluate(p, w)
+	if !eligible || reason != "will_be_6_months" {
+		t.Fatalf("Evaluate() = (%v, reason=%q), want (true, will_be_6_months)", eligible, reason)
+	}
+	wantStart := gregorian(t, "2021-07-05")
+	if !start.Equal(wantStart) {
+		t.Errorf("start = %v, want %v", start, wantStart)
+	}
+}
+
+func TestEvaluateSenior(t *testing.T) {
+	p := newPerson(t, "1950-03-10")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-08-31")}
+	eligible, start, end, reason := DefaultPolicy().Evaluate(p, w)
+	if !eligible || reason != "senior" {
+		t.Fatalf("Evaluate() = (%v, reason=%q), want (true, senior)", eligible, reason)
+	}
+	if !start.Equal(w.Start) || !end.Equal(w.End) {
+		t.Errorf("Evaluate() window = (%v, %v), want full window (%v, %v)", start, end, w.Start, w.End)
+	}
+}
+
+func TestEvaluateChildCapsEndAt2ndBirthday(t *testing.T) {
+	p := newPerson(t, "2019-08-01")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-08-31")}
+	eligible, start, end, reason := DefaultPolicy().Evaluate(p, w)
+	if !eligible || reason != "child" {
+		t.Fatalf("Evaluate() = (%v, reason=%q), want (true, child)", eligible, reason)
+	}
+	if !start.Equal(w.Start) {
+		t.Errorf("start = %v, want %v", start, w.Start)
+	}
+	wantEnd := gregorian(t, "2021-08-01")
+	if !end.Equal(wantEnd) {
+		t.Errorf("end = %v, want %v (2nd birthday, not w.End)", end, wantEnd)
+	}
+}
+
+func TestEvaluateOver2NotWillBe2yo(t *testing.T) {
+	p := newPerson(t, "2019-01-05")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-08-31")}
+	eligible, _, _, reason := DefaultPolicy().Evaluate(p, w)
+	if eligible {
+		t.Errorf("Evaluate() eligible = true, reason = %q, want false for a child already over 2 throughout the window", reason)
+	}
+}
+
+func TestEvaluateIneligible(t *testing.T) {
+	p := newPerson(t, "1990-01-01")
+	w := Window{Start: gregorian(t, "2021-06-01"), End: gregorian(t, "2021-08-31")}
+	eligible, _, _, reason := DefaultPolicy().Evaluate(p, w)
+	if eligible || reason != "ineligible" {
+		t.Errorf("Evaluate() = (%v, reason=%q), want (false, ineligible)", eligible, reason)
+	}
+}
@@ -0,0 +1,64 @@
+package eligibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadPolicy reads a Policy from r. filename's extension selects the
+// format: ".yaml"/".yml" for YAML, anything else for JSON.
+func LoadPolicy(r io.Reader, filename string) (Policy, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Policy{}, fmt.Errorf("eligibility: read policy: %w", err)
+	}
+	if strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml") {
+		return parsePolicyYAML(data)
+	}
+	return parsePolicyJSON(data)
+}
+
+func parsePolicyJSON(data []byte) (Policy, error) {
+	p := DefaultPolicy()
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Policy{}, fmt.Errorf("eligibility: parse policy JSON: %w", err)
+	}
+	return p, nil
+}
+
+// parsePolicyYAML parses the flat "key: value" subset of YAML a Policy
+// needs, field-for-field with its JSON tags. There are no nested maps,
+// lists, or strings to support, so a full YAML parser would be more
+// machinery than the format warrants.
+func parsePolicyYAML(data []byte) (Policy, error) {
+	p := DefaultPolicy()
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, rawValue, ok := strings.Cut(line, ":")
+		if !ok {
+			return Policy{}, fmt.Errorf("eligibility: malformed policy line %q", line)
+		}
+		key = strings.TrimSpace(key)
+		value, err := strconv.Atoi(strings.TrimSpace(rawValue))
+		if err != nil {
+			return Policy{}, fmt.Errorf("eligibility: invalid value for %q: %w", key, err)
+		}
+		switch key {
+		case "senior_lower_age_year":
+			p.SeniorLowerAgeYear = value
+		case "child_lower_age_month":
+			p.ChildLowerAgeMonth = value
+		case "child_upper_age_year":
+			p.ChildUpperAgeYear = value
+		default:
+			return Policy{}, fmt.Errorf("eligibility: unknown policy field %q", key)
+		}
+	}
+	return p, nil
+}
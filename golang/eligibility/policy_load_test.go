@@ -0,0 +1,37 @@
+package eligibility
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadPolicyJSON(t *testing.T) {
+	r := strings.NewReader(`{"senior_lower_age_year": 60, "child_lower_age_month": 3, "child_upper_age_year": 5}`)
+	got, err := LoadPolicy(r, "policy.json")
+	if err != nil {
+		t.Fatalf("LoadPolicy: %v", err)
+	}
+	want := Policy{SeniorLowerAgeYear: 60, ChildLowerAgeMonth: 3, ChildUpperAgeYear: 5}
+	if got != want {
+		t.Errorf("LoadPolicy() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadPolicyYAML(t *testing.T) {
+	r := strings.NewReader("senior_lower_age_year: 60\nchild_lower_age_month: 3\nchild_upper_age_year: 5\n")
+	got, err := LoadPolicy(r, "policy.yaml")
+	if err != nil {
+		t.Fatalf("LoadPolicy: %v", err)
+	}
+	want := Policy{SeniorLowerAgeYear: 60, ChildLowerAgeMonth: 3, ChildUpperAgeYear: 5}
+	if got != want {
+		t.Errorf("LoadPolicy() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadPolicyYAMLUnknownField(t *testing.T) {
+	r := strings.NewReader("not_a_field: 1\n")
+	if _, err := LoadPolicy(r, "policy.yml"); err == nil {
+		t.Error("LoadPolicy(): expected error for unknown field, got none")
+	}
+}
@@ -0,0 +1,236 @@
+// Package locale generalizes Thai-specific date formatting into a Locale
+// interface, with built-in Thai, English, and Japanese locales, so a
+// caller can format and parse dates in whichever calendar and language it
+// needs at runtime instead of always printing Thai.
+//
+// Layouts use "{Token}" placeholders rather than time.Format's reference-
+// date tokens, since a literal month name like "มกราคม" would otherwise be
+// indistinguishable from a format token: {D}/{DD} day, {M}/{MM} month
+// number, {YYYY} Gregorian year, {Era} the locale's era year, {Month} the
+// locale's full month name, {Mon} its abbreviation. Anything else in the
+// layout is passed through literally.
+package locale
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+)
+
+// Locale formats and names dates for a particular calendar and language.
+type Locale interface {
+	// MonthName returns the full month name, e.g. "มีนาคม" or "March".
+	MonthName(m time.Month) string
+	// MonthAbbrev returns the abbreviated month name, e.g. "มี.ค." or "Mar".
+	MonthAbbrev(m time.Month) string
+	// EraYear returns t's year numbered in the locale's era, e.g. BE 2564
+	// for CE 2021.
+	EraYear(t time.Time) int
+	// Format renders t using layout.
+	Format(t time.Time, layout string) string
+}
+
+// thaiMonthAbbrevs are the conventional Thai month abbreviations, indexed
+// from 0 (January).
+var thaiMonthAbbrevs = [...]string{
+	"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.",
+	"ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค.",
+}
+
+type thaiLocale struct{}
+
+func (thaiLocale) MonthName(m time.Month) string   { return beedate.ThaiMonths[m-1] }
+func (thaiLocale) MonthAbbrev(m time.Month) string { return thaiMonthAbbrevs[m-1] }
+func (thaiLocale) EraYear(t time.Time) int         { return t.Year() + beedate.BEOffset }
+func (loc thaiLocale) Format(t time.Time, layout string) string {
+	return formatLayout(t, layout, loc)
+}
+
+// Thai formats dates using Thai month names and the Buddhist Era.
+var Thai Locale = thaiLocale{}
+
+type englishLocale struct{}
+
+func (englishLocale) MonthName(m time.Month) string { return m.String() }
+func (englishLocale) MonthAbbrev(m time.Month) string {
+	return m.String()[:3]
+}
+func (englishLocale) EraYear(t time.Time) int { return t.Year() }
+func (loc englishLocale) Format(t time.Time, layout string) string {
+	return formatLayout(t, layout, loc)
+}
+
+// English formats dates using English month names and the Christian Era.
+var English Locale = englishLocale{}
+
+// japaneseEraEpoch is the Gregorian year before Reiwa 1 (which began in
+// May 2019). This locale deliberately does not model pre-Reiwa eras
+// (Heisei, Showa, ...) or the mid-year era transition; it exists to show a
+// second, distinct non-Gregorian era, not to be a complete Japanese
+// calendar.
+const japaneseEraEpoch = 2018
+
+var japaneseMonths = [...]string{
+	"1月", "2月", "3月", "4月", "5月", "6月",
+	"7月", "8月", "9月", "10月", "11月", "12月",
+}
+
+type japaneseLocale struct{}
+
+func (japaneseLocale) MonthName(m time.Month) string   { return japaneseMonths[m-1] }
+func (japaneseLocale) MonthAbbrev(m time.Month) string { return japaneseMonths[m-1] }
+func (japaneseLocale) EraYear(t time.Time) int         { return t.Year() - japaneseEraEpoch }
+func (loc japaneseLocale) Format(t time.Time, layout string) string {
+	return formatLayout(t, layout, loc)
+}
+
+// Japanese formats dates using Japanese month names and a simplified
+// single-epoch Reiwa era year.
+var Japanese Locale = japaneseLocale{}
+
+// ByName looks up a built-in Locale by its common name ("thai", "english"
+// or "japanese"), case-insensitively.
+func ByName(name string) (Locale, error) {
+	switch strings.ToLower(name) {
+	case "thai", "th":
+		return Thai, nil
+	case "english", "en":
+		return English, nil
+	case "japanese", "ja":
+		return Japanese, nil
+	}
+	return nil, fmt.Errorf("locale: unknown locale %q", name)
+}
+
+func formatLayout(t time.Time, layout string, loc Locale) string {
+	out := layout
+	out = strings.ReplaceAll(out, "{DD}", fmt.Sprintf("%02d", t.Day()))
+	out = strings.ReplaceAll(out, "{D}", strconv.Itoa(t.Day()))
+	out = strings.ReplaceAll(out, "{MM}", fmt.Sprintf("%02d", int(t.Month())))
+	out = strings.ReplaceAll(out, "{M}", strconv.Itoa(int(t.Month())))
+	out = strings.ReplaceAll(out, "{YYYY}", strconv.Itoa(t.Year()))
+	out = strings.ReplaceAll(out, "{Era}", strconv.Itoa(loc.EraYear(t)))
+	out = strings.ReplaceAll(out, "{Month}", loc.MonthName(t.Month()))
+	out = strings.ReplaceAll(out, "{Mon}", loc.MonthAbbrev(t.Month()))
+	return out
+}
+
+// offsetAnchor is a fixed reference date used to recover a Locale's
+// constant era offset (EraYear(t) - t.Year()) for ParseLocalized, since
+// Locale does not expose an inverse of EraYear directly. This only works
+// for locales whose era offset doesn't change within a year, which holds
+// for the simplified single-epoch locales built into this package.
+var offsetAnchor = time.Date(2000, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+func eraOffset(loc Locale) int {
+	return loc.EraYear(offsetAnchor) - offsetAnchor.Year()
+}
+
+var tokenPattern = regexp.MustCompile(`\{[A-Za-z]+\}`)
+
+func compileLayoutPattern(layout string) string {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, idx := range tokenPattern.FindAllStringIndex(layout, -1) {
+		pattern.WriteString(regexp.QuoteMeta(layout[last:idx[0]]))
+		token := layout[idx[0]+1 : idx[1]-1]
+		switch token {
+		case "D", "M":
+			fmt.Fprintf(&pattern, `(?P<%s>\d{1,2})`, token)
+		case "DD", "MM":
+			fmt.Fprintf(&pattern, `(?P<%s>\d{2})`, token)
+		case "YYYY":
+			pattern.WriteString(`(?P<YYYY>\d{4})`)
+		case "Era":
+			pattern.WriteString(`(?P<Era>\d+)`)
+		case "Month", "Mon":
+			fmt.Fprintf(&pattern, `(?P<%s>\S+)`, token)
+		default:
+			pattern.WriteString(regexp.QuoteMeta(layout[idx[0]:idx[1]]))
+		}
+		last = idx[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(layout[last:]))
+	pattern.WriteString("$")
+	return pattern.String()
+}
+
+func monthByName(loc Locale, name string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if loc.MonthName(m) == name {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+func monthByAbbrev(loc Locale, name string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if loc.MonthAbbrev(m) == name {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+// ParseLocalized parses s according to layout (using the same "{Token}"
+// placeholders as Locale.Format), resolving month names and era years
+// through loc, and returns the Gregorian equivalent.
+func ParseLocalized(layout, s string, loc Locale) (time.Time, error) {
+	re, err := regexp.Compile(compileLayoutPattern(layout))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("locale: invalid layout %q: %w", layout, err)
+	}
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("locale: %q does not match layout %q", s, layout)
+	}
+
+	var day, month, year int
+	for i, name := range re.SubexpNames() {
+		if i == 0 || name == "" {
+			continue
+		}
+		value := match[i]
+		switch name {
+		case "D", "DD":
+			day, err = strconv.Atoi(value)
+		case "M", "MM":
+			month, err = strconv.Atoi(value)
+		case "YYYY":
+			year, err = strconv.Atoi(value)
+		case "Era":
+			var eraYear int
+			eraYear, err = strconv.Atoi(value)
+			year = eraYear - eraOffset(loc)
+		case "Month":
+			m, ok := monthByName(loc, value)
+			if !ok {
+				return time.Time{}, fmt.Errorf("locale: unrecognized month name %q", value)
+			}
+			month = int(m)
+		case "Mon":
+			m, ok := monthByAbbrev(loc, value)
+			if !ok {
+				return time.Time{}, fmt.Errorf("locale: unrecognized month abbreviation %q", value)
+			}
+			month = int(m)
+		}
+		if err != nil {
+			return time.Time{}, fmt.Errorf("locale: parse %q: %w", s, err)
+		}
+	}
+	if month < 1 || month > 12 {
+		return time.Time{}, fmt.Errorf("locale: invalid month %d in %q", month, s)
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day {
+		return time.Time{}, fmt.Errorf("locale: invalid day %d for %04d-%02d in %q", day, year, month, s)
+	}
+	return t, nil
+}
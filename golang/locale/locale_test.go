@@ -0,0 +1,73 @@
+package locale
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThaiFormat(t *testing.T) {
+	d := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	got := Thai.Format(d, "{D} {Month} พ.ศ.{Era}")
+	want := "1 มิถุนายน พ.ศ.2564"
+	if got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestThaiMonthAbbrev(t *testing.T) {
+	if got, want := Thai.MonthAbbrev(time.March), "มี.ค."; got != want {
+		t.Errorf("MonthAbbrev(March) = %q, want %q", got, want)
+	}
+}
+
+func TestEnglishFormat(t *testing.T) {
+	d := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	got := English.Format(d, "{Month} {D}, {Era}")
+	want := "June 1, 2021"
+	if got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestJapaneseFormat(t *testing.T) {
+	d := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	got := Japanese.Format(d, "{Era}年{Month}{D}日")
+	want := "3年6月1日"
+	if got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestParseLocalizedThaiRoundTrip(t *testing.T) {
+	got, err := ParseLocalized("{D} {Month} พ.ศ.{Era}", "1 มิถุนายน พ.ศ.2564", Thai)
+	if err != nil {
+		t.Fatalf("ParseLocalized: %v", err)
+	}
+	want := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseLocalized() = %v, want %v", got, want)
+	}
+}
+
+func TestParseLocalizedEnglishRoundTrip(t *testing.T) {
+	got, err := ParseLocalized("{Month} {D}, {Era}", "June 1, 2021", English)
+	if err != nil {
+		t.Fatalf("ParseLocalized: %v", err)
+	}
+	want := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseLocalized() = %v, want %v", got, want)
+	}
+}
+
+func TestParseLocalizedUnrecognizedMonth(t *testing.T) {
+	if _, err := ParseLocalized("{Month} {D}, {Era}", "Notamonth 1, 2021", English); err == nil {
+		t.Error("ParseLocalized(): expected error for unrecognized month, got none")
+	}
+}
+
+func TestByNameUnknown(t *testing.T) {
+	if _, err := ByName("klingon"); err == nil {
+		t.Error("ByName(\"klingon\"): expected error, got none")
+	}
+}
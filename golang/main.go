@@ -1,141 +1,91 @@
 package main
 
 import (
+	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"time"
-)
-
-const DATETIME_LAYOUT = "2006-01-02"
-const START_DATE = "2564-06-01"
-const END_DATE = "2564-08-31"
-const SENIOR_LOWER_AGE_YEAR = 65
-const CHILD_LOWER_AGE_MONTH = 6
-const CHILD_UPPER_AGE_YEAR = 2
-var thaiMonths = [...]string{
-	"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน",
-	"กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม",
-}
+	"strings"
 
-
-type Gender int64
-const (
-	MALE Gender = iota
-	FEMALE
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+	"github.com/Tatchakorn/Dev-Assignment/golang/eligibility"
+	"github.com/Tatchakorn/Dev-Assignment/golang/locale"
+	"github.com/Tatchakorn/Dev-Assignment/golang/parsedate"
 )
 
-func (gender Gender) String() string {
-	switch gender {
-		case MALE:
-			return "male"
-		case FEMALE:
-			return "female"
-	}
-	return "unknown"
-}
-
-type Person struct {
-	gender Gender
-	birthdate time.Time
-}
+// defaultBirthdates is used when no file is given on the command line; the
+// formats are deliberately mixed (ISO, slash, dot, Thai month name) to show
+// parsedate.ParseBirthdate handling each.
+const defaultBirthdates = `2499-03-10
+08/10/2500
+01.07.2562
+5 มกราคม 2564
+`
 
-func (p Person) String() string {
-	return fmt.Sprintf("{%s: %v}", p.gender, thaiDateFormat(p.birthdate))
-}
-
-type Age struct {
-	year int
-	month int
-}
+const START_DATE = "2564-06-01"
+const END_DATE = "2564-08-31"
 
-func (a Age) String() string {
-	return fmt.Sprintf("[%dy %dm]", a.year, a.month)
+// dateLayouts gives each built-in locale an appropriate display layout, as
+// chosen by the CLI caller rather than baked into the Locale itself.
+var dateLayouts = map[string]string{
+	"thai":     "{D} {Month} พ.ศ.{Era}",
+	"english":  "{Month} {D}, {Era}",
+	"japanese": "{Era}年{Month}{D}日",
 }
 
-// returns age in (years, months) from birthdate relative to the set date.
-func (p Person) calcAge(setDate time.Time) Age {
-	ageYears := setDate.Year() - p.birthdate.Year()
-	setDateMonth := int(setDate.Month())
-	birthdateMonth := int(p.birthdate.Month())
+// wrapperEligible evaluates p against the CLI's fixed service window using
+// the default Policy, the same code path the HTTP service in server uses.
+func wrapperEligible(p eligibility.Person) (bool, *beedate.Date, *beedate.Date) {
+	startDate, err := beedate.Parse(START_DATE)
+	handleErr(err)
+	endDate, err := beedate.Parse(END_DATE)
+	handleErr(err)
 
-	// has passed the set date's birthday for that year
-	if setDateMonth < birthdateMonth || 
-		(setDateMonth == birthdateMonth && setDate.Day() < p.birthdate.Day()) {
-		ageYears--
+	window := eligibility.Window{Start: startDate.ToGregorian(), End: endDate.ToGregorian()}
+	eligible, start, end, _ := eligibility.DefaultPolicy().Evaluate(p, window)
+	if !eligible {
+		return false, nil, nil
 	}
-	
-	// prefer positive as modulus operands
-	ageMonths := ((setDateMonth - birthdateMonth) + 12) % 12
-	return Age{ageYears, ageMonths}
+	startBE := beedate.FromGregorian(start)
+	endBE := beedate.FromGregorian(end)
+	return true, &startBE, &endBE
 }
 
+func main() {
+	localeName := flag.String("locale", "thai", "locale for date output: thai, english, or japanese")
+	flag.Parse()
 
-// returns if the person is able to apply for the service within the sevice period
-// with start and end date of the period when the person can apply for the service.
-// For senior citizens (65 years old or older) 
-// [65, inf)
-// For children (between 6 months and 2 years old) 
-// [0.6, 2]
-// If the person does not meet any of these criteria, they are deemed ineligible.
-// This function will modify the age of the person though
-func (p Person) eligible(startDate, endDate time.Time) (bool, time.Time , time.Time) {
-	ageStart := p.calcAge(startDate)
-	ageEnd := p.calcAge(endDate)
-	fmt.Println(ageStart,ageEnd)
-	inSeniorRange := ageStart.year >= SENIOR_LOWER_AGE_YEAR
-	inChildrenRange := ageStart.month >= CHILD_LOWER_AGE_MONTH && ageEnd.year <= CHILD_UPPER_AGE_YEAR && ageEnd.month < 1
-	willBe65yo := ageEnd.year == SENIOR_LOWER_AGE_YEAR
-	willBe6m := (ageEnd.month >= CHILD_LOWER_AGE_MONTH) && (ageEnd.year < CHILD_UPPER_AGE_YEAR)
-	willBe2yo := ageEnd.year == CHILD_UPPER_AGE_YEAR
-	
-	if inSeniorRange || inChildrenRange {
-		return true, startDate, endDate
-	} else if willBe65yo {
-		return true, p.birthdate.AddDate(65, 0, 0), endDate
-	} else if willBe6m {
-		return true, p.birthdate.AddDate(0, 6, 0), endDate
-	} else if willBe2yo {
-		return true, startDate, p.birthdate.AddDate(2, 0, 0)
+	loc, err := locale.ByName(*localeName)
+	handleErr(err)
+	layout, ok := dateLayouts[strings.ToLower(*localeName)]
+	if !ok {
+		layout = dateLayouts["thai"]
 	}
 
-	// ineligible
-	return false, time.Time{}, time.Time{}
-}
+	var src io.Reader = strings.NewReader(defaultBirthdates)
+	if flag.NArg() > 0 {
+		f, err := os.Open(flag.Arg(0))
+		handleErr(err)
+		defer f.Close()
+		src = f
+	}
 
-// Just to return nil
-func (p Person) wrapperEligible() (bool, *time.Time, *time.Time) {
-	startDate, err  := time.Parse(DATETIME_LAYOUT, START_DATE)
+	dates, err := readBirthdates(src)
 	handleErr(err)
-	endDate, err := time.Parse(DATETIME_LAYOUT, END_DATE)
-	handleErr(err)
-	eligible, start, end := p.eligible(startDate, endDate)
-	if start.IsZero() && end.IsZero() {
-		return eligible, nil, nil 
-	}
-	return eligible, &start, &end
-}
 
-func main() {
-	bdates := [...]string {
-		"2499-03-10", // 10 มีนาคม พ.ศ.2499
-		"2500-10-08", // 8 ตุลาคม พ.ศ.2500 
-		"2562-07-01", // 1 กรกฎาคม พ.ศ.2562 
-		"2564-01-05", // 5 มกราคม พ.ศ.2564
-	}
-	gens := [...]Gender { FEMALE, MALE, FEMALE, FEMALE, }
-	var persons []Person
-	
-	for i := 0; i < len(bdates); i++ {
-		date, err := time.Parse(DATETIME_LAYOUT, bdates[i])
-		handleErr(err)
-		persons = append(persons, Person{gens[i], date})
+	gens := [...]eligibility.Gender{eligibility.FEMALE, eligibility.MALE, eligibility.FEMALE, eligibility.FEMALE}
+	var persons []eligibility.Person
+
+	for i, date := range dates {
+		persons = append(persons, eligibility.Person{Gender: gens[i%len(gens)], Birthdate: date})
 	}
-	
-	for i := 0; i < len(persons); i++ {
-		fmt.Printf("%d: %s\n", i+1, persons[i])
-		eligible, start, end := persons[i].wrapperEligible()
+
+	for i, person := range persons {
+		fmt.Printf("%d: {%s: %s}\n", i+1, person.Gender, loc.Format(person.Birthdate.ToGregorian(), layout))
+		eligible, start, end := wrapperEligible(person)
 		if start != nil && end != nil {
-			fmt.Println(eligible, thaiDateFormat(*start), thaiDateFormat(*end))
+			fmt.Println(eligible, loc.Format(start.ToGregorian(), layout), loc.Format(end.ToGregorian(), layout))
 		} else {
 			fmt.Println(eligible, start, end)
 		}
@@ -143,13 +93,32 @@ func main() {
 	}
 }
 
+// readBirthdates reads one birthdate per line from r, each in any format
+// parsedate.ParseBirthdate understands, and returns them as beedate.Date
+// values.
+func readBirthdates(r io.Reader) ([]beedate.Date, error) {
+	var dates []beedate.Date
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		t, _, err := parsedate.ParseBirthdate(line)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, beedate.FromGregorian(t))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return dates, nil
+}
+
 func handleErr(err error) {
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
-
-func thaiDateFormat(date time.Time) string {
-	return fmt.Sprintf("%d %s พ.ศ.%d", date.Day(), thaiMonths[date.Month()-1], date.Year())
-}
\ No newline at end of file
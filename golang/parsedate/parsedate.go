@@ -0,0 +1,219 @@
+// Package parsedate recognizes birthdates written in any of a handful of
+// common formats (ISO, slash, dot, or a day-month-name-year form with Thai
+// or English month names) without guessing via a list of time.Parse
+// layouts. Input is lexed into number/word tokens once, then matched
+// against a small table of accepted formats.
+package parsedate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+)
+
+// Era identifies which calendar a parsed year was expressed in.
+type Era int
+
+const (
+	// ChristianEra years are the familiar Gregorian years, e.g. 2021.
+	ChristianEra Era = iota
+	// BuddhistEra years are CE+543, e.g. 2564.
+	BuddhistEra
+)
+
+// beYearThreshold is the year above which a bare number is assumed to be a
+// Buddhist Era year rather than a Christian Era one: no Gregorian year this
+// side of the year 2400 has ever been written in everyday use, while BE
+// years for the entire modern era are comfortably above it (BE 2564 = CE
+// 2021).
+const beYearThreshold = 2400
+
+var englishMonths = buildEnglishMonths()
+
+func buildEnglishMonths() map[string]time.Month {
+	months := make(map[string]time.Month, 24)
+	for m := time.January; m <= time.December; m++ {
+		full := strings.ToLower(m.String())
+		months[full] = m
+		months[full[:3]] = m
+	}
+	return months
+}
+
+func lookupMonth(word string) (time.Month, bool) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	for i, name := range beedate.ThaiMonths {
+		if name == word {
+			return time.Month(i + 1), true
+		}
+	}
+	if m, ok := englishMonths[word]; ok {
+		return m, true
+	}
+	return 0, false
+}
+
+type tokenKind int
+
+const (
+	tokNumber tokenKind = iota
+	tokWord
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// lex splits s into a sequence of number and word tokens, discarding
+// separators ('-', '/', '.', whitespace).
+func lex(s string) []token {
+	var tokens []token
+	var cur strings.Builder
+	curKind := tokNumber
+	open := false
+
+	flush := func() {
+		if open {
+			tokens = append(tokens, token{curKind, cur.String()})
+			cur.Reset()
+			open = false
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case unicode.IsDigit(r):
+			if open && curKind != tokNumber {
+				flush()
+			}
+			curKind = tokNumber
+			open = true
+			cur.WriteRune(r)
+		case unicode.IsLetter(r) || unicode.IsMark(r):
+			// Marks (e.g. Thai vowel and tone signs) belong to the word
+			// they sit on, not a separator.
+			if open && curKind != tokWord {
+				flush()
+			}
+			curKind = tokWord
+			open = true
+			cur.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// format is one accepted input shape: a token-kind signature to match
+// against, and a builder that turns the matched tokens into a day, month
+// and year.
+type format struct {
+	name  string
+	kinds []tokenKind
+	build func(tokens []token) (day, month, year int, err error)
+}
+
+var formats = []format{
+	{
+		name:  "YYYY-MM-DD or DD/MM/YYYY or DD.MM.YYYY",
+		kinds: []tokenKind{tokNumber, tokNumber, tokNumber},
+		build: func(tokens []token) (day, month, year int, err error) {
+			a, err := strconv.Atoi(tokens[0].text)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			b, err := strconv.Atoi(tokens[1].text)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			c, err := strconv.Atoi(tokens[2].text)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			if len(tokens[0].text) == 4 {
+				// YYYY-MM-DD
+				return c, b, a, nil
+			}
+			// DD/MM/YYYY or DD.MM.YYYY
+			return a, b, c, nil
+		},
+	},
+	{
+		name:  "D MMMM YYYY",
+		kinds: []tokenKind{tokNumber, tokWord, tokNumber},
+		build: func(tokens []token) (day, month, year int, err error) {
+			day, err = strconv.Atoi(tokens[0].text)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			m, ok := lookupMonth(tokens[1].text)
+			if !ok {
+				return 0, 0, 0, fmt.Errorf("parsedate: unrecognized month name %q", tokens[1].text)
+			}
+			year, err = strconv.Atoi(tokens[2].text)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			return day, int(m), year, nil
+		},
+	},
+}
+
+func matches(tokens []token, kinds []tokenKind) bool {
+	if len(tokens) != len(kinds) {
+		return false
+	}
+	for i, k := range kinds {
+		if tokens[i].kind != k {
+			return false
+		}
+	}
+	return true
+}
+
+func inferEra(year int) Era {
+	if year > beYearThreshold {
+		return BuddhistEra
+	}
+	return ChristianEra
+}
+
+// ParseBirthdate recognizes a birthdate written as "YYYY-MM-DD",
+// "DD/MM/YYYY", "DD.MM.YYYY", or "D MMMM YYYY" (with a Thai or English,
+// full or abbreviated, month name), inferring whether the year is Buddhist
+// Era (> 2400) or Christian Era, and returns the Gregorian equivalent.
+func ParseBirthdate(s string) (time.Time, Era, error) {
+	tokens := lex(s)
+	for _, f := range formats {
+		if !matches(tokens, f.kinds) {
+			continue
+		}
+		day, month, year, err := f.build(tokens)
+		if err != nil {
+			return time.Time{}, 0, err
+		}
+		era := inferEra(year)
+		if era == BuddhistEra {
+			year -= beedate.BEOffset
+		}
+		if month < 1 || month > 12 {
+			return time.Time{}, 0, fmt.Errorf("parsedate: invalid month %d in %q", month, s)
+		}
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if t.Day() != day {
+			// time.Date normalizes out-of-range days by rolling over into
+			// the next month; catch that instead of returning a silently
+			// shifted date.
+			return time.Time{}, 0, fmt.Errorf("parsedate: invalid day %d for %04d-%02d in %q", day, year, month, s)
+		}
+		return t, era, nil
+	}
+	return time.Time{}, 0, fmt.Errorf("parsedate: unrecognized date format %q", s)
+}
@@ -0,0 +1,89 @@
+package parsedate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBirthdateISO(t *testing.T) {
+	got, era, err := ParseBirthdate("2564-06-01")
+	if err != nil {
+		t.Fatalf("ParseBirthdate: %v", err)
+	}
+	if era != BuddhistEra {
+		t.Errorf("era = %v, want BuddhistEra", era)
+	}
+	want := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseBirthdate() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBirthdateSlashAmbiguous(t *testing.T) {
+	// DD/MM/YYYY: day=01, month=02 (February), not month=01 day=02.
+	got, era, err := ParseBirthdate("01/02/2564")
+	if err != nil {
+		t.Fatalf("ParseBirthdate: %v", err)
+	}
+	if era != BuddhistEra {
+		t.Errorf("era = %v, want BuddhistEra", era)
+	}
+	want := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseBirthdate() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBirthdateDot(t *testing.T) {
+	got, era, err := ParseBirthdate("01.07.2562")
+	if err != nil {
+		t.Fatalf("ParseBirthdate: %v", err)
+	}
+	if era != BuddhistEra {
+		t.Errorf("era = %v, want BuddhistEra", era)
+	}
+	want := time.Date(2019, time.July, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseBirthdate() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBirthdateThaiMonthName(t *testing.T) {
+	got, era, err := ParseBirthdate("5 มกราคม 2564")
+	if err != nil {
+		t.Fatalf("ParseBirthdate: %v", err)
+	}
+	if era != BuddhistEra {
+		t.Errorf("era = %v, want BuddhistEra", era)
+	}
+	want := time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseBirthdate() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBirthdateEnglishMonthNameChristianEra(t *testing.T) {
+	got, era, err := ParseBirthdate("5 Jan 2021")
+	if err != nil {
+		t.Fatalf("ParseBirthdate: %v", err)
+	}
+	if era != ChristianEra {
+		t.Errorf("era = %v, want ChristianEra", era)
+	}
+	want := time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseBirthdate() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBirthdateInvalidFormat(t *testing.T) {
+	if _, _, err := ParseBirthdate("not a date"); err == nil {
+		t.Error("ParseBirthdate(\"not a date\"): expected error, got none")
+	}
+}
+
+func TestParseBirthdateInvalidDay(t *testing.T) {
+	if _, _, err := ParseBirthdate("2564-02-30"); err == nil {
+		t.Error("ParseBirthdate(2564-02-30): expected error, got none")
+	}
+}
@@ -0,0 +1,137 @@
+// Package server exposes the eligibility Policy over HTTP/JSON, so the
+// same rules the CLI applies can be queried as a service.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/beedate"
+	"github.com/Tatchakorn/Dev-Assignment/golang/eligibility"
+)
+
+// Server evaluates eligibility requests against a fixed Policy.
+type Server struct {
+	Policy eligibility.Policy
+}
+
+// New returns a Server that evaluates requests against policy.
+func New(policy eligibility.Policy) *Server {
+	return &Server{Policy: policy}
+}
+
+// Handler returns the http.Handler serving the eligibility endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eligibility", s.handleEligibility)
+	return mux
+}
+
+type personInput struct {
+	Gender    string `json:"gender"`
+	Birthdate string `json:"birthdate"`
+}
+
+type windowInput struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type eligibilityRequest struct {
+	Persons []personInput `json:"persons"`
+	Window  windowInput   `json:"window"`
+}
+
+type personResult struct {
+	Eligible bool    `json:"eligible"`
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Reason   string  `json:"reason"`
+}
+
+type eligibilityResponse struct {
+	Results []personResult `json:"results"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *Server) handleEligibility(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req eligibilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if len(req.Persons) == 0 {
+		writeError(w, http.StatusBadRequest, "persons must not be empty")
+		return
+	}
+
+	window, err := parseWindow(req.Window)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results := make([]personResult, len(req.Persons))
+	for i, pi := range req.Persons {
+		person, err := parsePerson(pi)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("persons[%d]: %v", i, err))
+			return
+		}
+
+		eligible, start, end, reason := s.Policy.Evaluate(person, window)
+		result := personResult{Eligible: eligible, Reason: reason}
+		if eligible {
+			startStr := beedate.FromGregorian(start).String()
+			endStr := beedate.FromGregorian(end).String()
+			result.Start = &startStr
+			result.End = &endStr
+		}
+		results[i] = result
+	}
+
+	writeJSON(w, http.StatusOK, eligibilityResponse{Results: results})
+}
+
+func parsePerson(in personInput) (eligibility.Person, error) {
+	gender, err := eligibility.ParseGender(in.Gender)
+	if err != nil {
+		return eligibility.Person{}, err
+	}
+	birthdate, err := beedate.Parse(in.Birthdate)
+	if err != nil {
+		return eligibility.Person{}, fmt.Errorf("invalid birthdate %q: %w", in.Birthdate, err)
+	}
+	return eligibility.Person{Gender: gender, Birthdate: birthdate}, nil
+}
+
+func parseWindow(in windowInput) (eligibility.Window, error) {
+	start, err := beedate.Parse(in.Start)
+	if err != nil {
+		return eligibility.Window{}, fmt.Errorf("invalid window.start %q: %w", in.Start, err)
+	}
+	end, err := beedate.Parse(in.End)
+	if err != nil {
+		return eligibility.Window{}, fmt.Errorf("invalid window.end %q: %w", in.End, err)
+	}
+	return eligibility.Window{Start: start.ToGregorian(), End: end.ToGregorian()}, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Tatchakorn/Dev-Assignment/golang/eligibility"
+)
+
+func TestEligibilityEndpointSenior(t *testing.T) {
+	srv := httptest.NewServer(New(eligibility.DefaultPolicy()).Handler())
+	defer srv.Close()
+
+	body := `{"persons":[{"gender":"female","birthdate":"2499-03-10"}],"window":{"start":"2564-06-01","end":"2564-08-31"}}`
+	resp, err := http.Post(srv.URL+"/eligibility", "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("POST /eligibility: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var got eligibilityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(got.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(got.Results))
+	}
+	result := got.Results[0]
+	if !result.Eligible || result.Reason != "senior" {
+		t.Errorf("Results[0] = %+v, want eligible=true reason=senior", result)
+	}
+	if result.Start == nil || *result.Start != "2564-06-01" {
+		t.Errorf("Results[0].Start = %v, want 2564-06-01", result.Start)
+	}
+}
+
+func TestEligibilityEndpointRejectsEmptyPersons(t *testing.T) {
+	srv := httptest.NewServer(New(eligibility.DefaultPolicy()).Handler())
+	defer srv.Close()
+
+	body := `{"persons":[],"window":{"start":"2564-06-01","end":"2564-08-31"}}`
+	resp, err := http.Post(srv.URL+"/eligibility", "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("POST /eligibility: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+	var got errorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Error == "" {
+		t.Error("expected a non-empty error message")
+	}
+}
+
+func TestEligibilityEndpointRejectsBadGender(t *testing.T) {
+	srv := httptest.NewServer(New(eligibility.DefaultPolicy()).Handler())
+	defer srv.Close()
+
+	body := `{"persons":[{"gender":"robot","birthdate":"2499-03-10"}],"window":{"start":"2564-06-01","end":"2564-08-31"}}`
+	resp, err := http.Post(srv.URL+"/eligibility", "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("POST /eligibility: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestEligibilityEndpointRejectsGet(t *testing.T) {
+	srv := httptest.NewServer(New(eligibility.DefaultPolicy()).Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/eligibility")
+	if err != nil {
+		t.Fatalf("GET /eligibility: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}